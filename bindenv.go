@@ -0,0 +1,41 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// BindEnv maps key to an ordered list of candidate environment variable
+// names, independent of any EnvSource prefix or separator scheme. The
+// first variable with a non-empty value wins and is coerced the same way
+// EnvSource coerces values. If vars is omitted, the variable name is
+// derived from key by upper-casing it and replacing "." with "_" (e.g.
+// "server.port" becomes "SERVER_PORT").
+func (c *ConfigManager) BindEnv(key string, vars ...string) {
+	if len(vars) == 0 {
+		vars = []string{defaultEnvVarName(key)}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, name := range vars {
+		value, exists := os.LookupEnv(name)
+		if !exists || value == "" {
+			continue
+		}
+
+		coerced := coerceScalar(value)
+		setPath(c.values, key, coerced)
+		setPath(c.overrides, key, coerced)
+		c.origins[key] = fmt.Sprintf("env binding: %s", name)
+		return
+	}
+}
+
+// defaultEnvVarName derives a conventional env var name from a dotted
+// config key, e.g. "server.port" becomes "SERVER_PORT".
+func defaultEnvVarName(key string) string {
+	return strings.ToUpper(strings.ReplaceAll(key, pathSeparator, "_"))
+}
@@ -0,0 +1,108 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/MichaelAJay/go-config"
+)
+
+func TestConfigManager_BindEnv_FirstNonEmptyWins(t *testing.T) {
+	t.Setenv("PRIMARY_DSN", "")
+	t.Setenv("FALLBACK_DSN", "postgres://localhost")
+
+	cfg := config.New()
+	cfg.BindEnv("db.dsn", "PRIMARY_DSN", "FALLBACK_DSN")
+
+	if val, ok := cfg.GetString("db.dsn"); !ok || val != "postgres://localhost" {
+		t.Errorf("db.dsn: got %v, %v, want %v, %v", val, ok, "postgres://localhost", true)
+	}
+	if got := cfg.Origin("db.dsn"); got != "env binding: FALLBACK_DSN" {
+		t.Errorf("Origin: got %q", got)
+	}
+}
+
+func TestConfigManager_BindEnv_DefaultVarName(t *testing.T) {
+	t.Setenv("SERVER_PORT", "9090")
+
+	cfg := config.New()
+	cfg.BindEnv("server.port")
+
+	if val, ok := cfg.GetInt("server.port"); !ok || val != 9090 {
+		t.Errorf("server.port: got %v, %v, want %v, %v", val, ok, 9090, true)
+	}
+}
+
+func TestConfigManager_BindEnv_Unset(t *testing.T) {
+	cfg := config.New()
+	cfg.BindEnv("missing.key", "DEFINITELY_NOT_SET_VAR")
+
+	if _, ok := cfg.Get("missing.key"); ok {
+		t.Error("Get should return false when no bound env var is set")
+	}
+}
+
+func TestEnvSource_DoubleUnderscoreSeparator(t *testing.T) {
+	t.Setenv("APP__SERVER__PORT", "8080")
+	t.Setenv("APP__DB_HOST", "localhost")
+
+	src := &config.EnvSource{Prefix: "APP__"}
+	values, err := src.Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	nested, ok := values["server"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected values[\"server\"] to be a map, got %T", values["server"])
+	}
+	if nested["port"] != 8080 {
+		t.Errorf("server.port: got %v, want 8080", nested["port"])
+	}
+	if values["db_host"] != "localhost" {
+		t.Errorf("db_host: got %v, want %q", values["db_host"], "localhost")
+	}
+}
+
+func TestCoerceScalar_RejectsTrailingGarbage(t *testing.T) {
+	t.Setenv("APP__COUNT", "42abc")
+
+	src := &config.EnvSource{Prefix: "APP__"}
+	values, err := src.Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if values["count"] != "42abc" {
+		t.Errorf("count: got %v (%T), want the literal string %q", values["count"], values["count"], "42abc")
+	}
+}
+
+func TestCoerceScalar_CommaSeparatedList(t *testing.T) {
+	t.Setenv("APP__TAGS", "a, b, c")
+
+	src := &config.EnvSource{Prefix: "APP__"}
+	values, err := src.Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	list, ok := values["tags"].([]string)
+	if !ok || len(list) != 3 || list[0] != "a" || list[1] != "b" || list[2] != "c" {
+		t.Errorf("tags: got %v (%T)", values["tags"], values["tags"])
+	}
+}
+
+func TestCoerceScalar_JSONLiteral(t *testing.T) {
+	t.Setenv("APP__LIMITS", `{"max": 10}`)
+
+	src := &config.EnvSource{Prefix: "APP__"}
+	values, err := src.Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	limits, ok := values["limits"].(map[string]any)
+	if !ok || limits["max"] != float64(10) {
+		t.Errorf("limits: got %v (%T)", values["limits"], values["limits"])
+	}
+}
@@ -7,7 +7,8 @@ import (
 
 // Config represents the configuration interface
 type Config interface {
-	// Get retrieves a configuration value by key
+	// Get retrieves a configuration value by key, supporting dotted paths
+	// (e.g. "server.http.port") into nested configuration maps
 	Get(key string) (any, bool)
 
 	// GetString retrieves a string configuration value
@@ -43,26 +44,35 @@ type Source interface {
 
 // ConfigManager implements the Config interface
 type ConfigManager struct {
-	values     map[string]any
-	mu         sync.RWMutex
-	validators []Validator
+	values        map[string]any
+	overrides     map[string]any
+	origins       map[string]string
+	sources       []*registeredSource
+	strict        bool
+	watchStops    []func() error
+	onChangeFuncs []func(key string, oldVal, newVal any)
+	resolvers     map[string]SecretProvider
+	mu            sync.RWMutex
+	validators    []Validator
 }
 
 // New creates a new configuration manager
 func New() *ConfigManager {
 	return &ConfigManager{
 		values:     make(map[string]any),
+		overrides:  make(map[string]any),
+		origins:    make(map[string]string),
 		validators: make([]Validator, 0),
 	}
 }
 
-// Get retrieves a configuration value by key
+// Get retrieves a configuration value by key. Keys may use dot notation
+// (e.g. "server.http.port") to address values nested within loaded maps.
 func (c *ConfigManager) Get(key string) (any, bool) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	value, exists := c.values[key]
-	return value, exists
+	return getPath(c.values, key)
 }
 
 // GetString retrieves a string configuration value
@@ -86,6 +96,8 @@ func (c *ConfigManager) GetInt(key string) (int, bool) {
 	switch v := value.(type) {
 	case int:
 		return v, true
+	case int64:
+		return int(v), true
 	case float64:
 		return int(v), true
 	default:
@@ -116,6 +128,8 @@ func (c *ConfigManager) GetFloat(key string) (float64, bool) {
 		return v, true
 	case int:
 		return float64(v), true
+	case int64:
+		return float64(v), true
 	default:
 		return 0, false
 	}
@@ -146,16 +160,24 @@ func (c *ConfigManager) GetStringSlice(key string) ([]string, bool) {
 	}
 }
 
-// Set sets a configuration value
+// Set sets a configuration value. Keys may use dot notation (e.g.
+// "server.http.port") to address nested values; intermediate maps are
+// created as needed. Set values take precedence over every registered
+// Source and survive a Reload.
 func (c *ConfigManager) Set(key string, value any) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	c.values[key] = value
+	setPath(c.values, key, value)
+	setPath(c.overrides, key, value)
+	c.origins[key] = originExplicitSet
 	return nil
 }
 
-// Load loads configuration from a source
+// Load loads configuration from a source and deep-merges it into the
+// current values, overwriting any leaf keys the source supplies. Unlike
+// AddSource, a Load'ed source is not retained and will not be re-read by
+// Reload.
 func (c *ConfigManager) Load(source Source) error {
 	values, err := source.Load()
 	if err != nil {
@@ -165,9 +187,7 @@ func (c *ConfigManager) Load(source Source) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	for k, v := range values {
-		c.values[k] = v
-	}
+	deepMerge(c.values, values, "", c.origins, sourceName(source))
 
 	return nil
 }
@@ -0,0 +1,46 @@
+package config
+
+import "strings"
+
+// parseDotEnv parses dotenv-style "KEY=value" content into a map[string]any,
+// applying the same scalar type coercion as EnvSource. Blank lines, "#"
+// comments, an optional leading "export ", and both single- and
+// double-quoted values (with unquoted trailing "# ..." comments stripped)
+// are supported.
+func parseDotEnv(data []byte) (map[string]any, error) {
+	values := make(map[string]any)
+
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		key, rawValue, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+
+		key = strings.ToLower(strings.TrimSpace(key))
+		setPath(values, key, coerceScalar(parseDotEnvValue(strings.TrimSpace(rawValue))))
+	}
+
+	return values, nil
+}
+
+// parseDotEnvValue strips surrounding quotes from a dotenv value, or, for
+// an unquoted value, strips a trailing " # comment" suffix.
+func parseDotEnvValue(raw string) string {
+	if len(raw) >= 2 {
+		if (raw[0] == '"' && raw[len(raw)-1] == '"') || (raw[0] == '\'' && raw[len(raw)-1] == '\'') {
+			return raw[1 : len(raw)-1]
+		}
+	}
+
+	if idx := strings.Index(raw, " #"); idx >= 0 {
+		raw = strings.TrimSpace(raw[:idx])
+	}
+
+	return raw
+}
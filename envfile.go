@@ -0,0 +1,57 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// EnvFileSource loads a base config file and deep-merges an environment
+// specific overlay on top of it, e.g. "config.yaml" plus "config.production.yaml"
+// when Env is "production". The overlay file is optional; if it does not
+// exist, only the base file's values are used.
+type EnvFileSource struct {
+	BasePath string
+	Env      string
+}
+
+// NewEnvFileSource returns an EnvFileSource for basePath and env.
+func NewEnvFileSource(basePath, env string) *EnvFileSource {
+	return &EnvFileSource{BasePath: basePath, Env: env}
+}
+
+// String implements fmt.Stringer, used when reporting a key's Origin.
+func (s *EnvFileSource) String() string {
+	return fmt.Sprintf("EnvFileSource(%s, %s)", s.BasePath, s.Env)
+}
+
+// Load implements the Source interface
+func (s *EnvFileSource) Load() (map[string]any, error) {
+	base := &FileSource{Path: s.BasePath}
+	values, err := base.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	overlay := &FileSource{Path: s.overlayPath()}
+	overlayValues, err := overlay.Load()
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return values, nil
+		}
+		return nil, err
+	}
+
+	deepMerge(values, overlayValues, "", nil, "")
+
+	return values, nil
+}
+
+// overlayPath inserts Env before the base path's extension, e.g.
+// "config.yaml" with Env "production" becomes "config.production.yaml".
+func (s *EnvFileSource) overlayPath() string {
+	ext := filepath.Ext(s.BasePath)
+	base := s.BasePath[:len(s.BasePath)-len(ext)]
+	return base + "." + s.Env + ext
+}
@@ -0,0 +1,54 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MichaelAJay/go-config"
+)
+
+func TestEnvFileSource_MergesOverlay(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "config.yaml")
+	overlay := filepath.Join(dir, "config.production.yaml")
+
+	if err := os.WriteFile(base, []byte("server:\n  port: 8080\n  host: localhost\n"), 0o644); err != nil {
+		t.Fatalf("write base file: %v", err)
+	}
+	if err := os.WriteFile(overlay, []byte("server:\n  port: 9090\n"), 0o644); err != nil {
+		t.Fatalf("write overlay file: %v", err)
+	}
+
+	src := config.NewEnvFileSource(base, "production")
+	cfg := config.New()
+	if err := cfg.Load(src); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if val, ok := cfg.GetInt("server.port"); !ok || val != 9090 {
+		t.Errorf("server.port: got %v, %v, want %v, %v", val, ok, 9090, true)
+	}
+	if val, ok := cfg.GetString("server.host"); !ok || val != "localhost" {
+		t.Errorf("server.host: got %v, %v, want %v, %v", val, ok, "localhost", true)
+	}
+}
+
+func TestEnvFileSource_MissingOverlayIsOptional(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "config.yaml")
+
+	if err := os.WriteFile(base, []byte("server:\n  port: 8080\n"), 0o644); err != nil {
+		t.Fatalf("write base file: %v", err)
+	}
+
+	src := config.NewEnvFileSource(base, "staging")
+	cfg := config.New()
+	if err := cfg.Load(src); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if val, ok := cfg.GetInt("server.port"); !ok || val != 8080 {
+		t.Errorf("server.port: got %v, %v, want %v, %v", val, ok, 8080, true)
+	}
+}
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
+	"github.com/BurntSushi/toml"
+	"github.com/fsnotify/fsnotify"
 	"gopkg.in/yaml.v3"
 )
 
@@ -15,6 +18,11 @@ type FileSource struct {
 	Path string
 }
 
+// String implements fmt.Stringer, used when reporting a key's Origin.
+func (s *FileSource) String() string {
+	return fmt.Sprintf("FileSource(%s)", s.Path)
+}
+
 // Load implements the Source interface
 func (s *FileSource) Load() (map[string]any, error) {
 	data, err := os.ReadFile(s.Path)
@@ -34,6 +42,15 @@ func (s *FileSource) Load() (map[string]any, error) {
 		if err := yaml.Unmarshal(data, &values); err != nil {
 			return nil, fmt.Errorf("failed to parse YAML config: %w", err)
 		}
+	case ".toml":
+		if err := toml.Unmarshal(data, &values); err != nil {
+			return nil, fmt.Errorf("failed to parse TOML config: %w", err)
+		}
+	case ".env":
+		var err error
+		if values, err = parseDotEnv(data); err != nil {
+			return nil, fmt.Errorf("failed to parse dotenv config: %w", err)
+		}
 	default:
 		return nil, fmt.Errorf("unsupported config file format: %s", ext)
 	}
@@ -41,15 +58,81 @@ func (s *FileSource) Load() (map[string]any, error) {
 	return values, nil
 }
 
+// Watch implements Watchable, invoking onChange whenever the file is
+// written or replaced. The containing directory is watched rather than the
+// file itself so that editors which save by rename-and-replace are still
+// detected.
+func (s *FileSource) Watch(onChange func()) (stop func() error, err error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file watcher: %w", err)
+	}
+
+	dir := filepath.Dir(s.Path)
+	if err := watcher.Add(dir); err != nil {
+		watcher.Close()
+		return nil, fmt.Errorf("failed to watch directory %s: %w", dir, err)
+	}
+
+	target := filepath.Clean(s.Path)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) == target && event.Op&(fsnotify.Write|fsnotify.Create) != 0 {
+					onChange()
+				}
+			case _, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	stop = func() error {
+		close(done)
+		return watcher.Close()
+	}
+
+	return stop, nil
+}
+
+// defaultEnvSeparator is used to split hierarchical env var names into
+// dotted config keys when EnvSource.Separator is unset, e.g.
+// APP__SERVER__PORT becomes "server.port". A double underscore is used by
+// default (rather than a single one) so that single underscores inside a
+// segment name, such as APP_DB_HOST, survive intact.
+const defaultEnvSeparator = "__"
+
 // EnvSource loads configuration from environment variables
 type EnvSource struct {
 	Prefix string
+	// Separator splits a hierarchical env var name into dotted config key
+	// segments. Defaults to defaultEnvSeparator ("__") when empty.
+	Separator string
+}
+
+// String implements fmt.Stringer, used when reporting a key's Origin.
+func (s *EnvSource) String() string {
+	return fmt.Sprintf("EnvSource(%s)", s.Prefix)
 }
 
 // Load implements the Source interface
 func (s *EnvSource) Load() (map[string]any, error) {
 	values := make(map[string]any)
 	prefix := strings.ToUpper(s.Prefix)
+	separator := s.Separator
+	if separator == "" {
+		separator = defaultEnvSeparator
+	}
 
 	for _, env := range os.Environ() {
 		parts := strings.SplitN(env, "=", 2)
@@ -62,21 +145,12 @@ func (s *EnvSource) Load() (map[string]any, error) {
 			continue
 		}
 
-		// Convert the key to lowercase and remove the prefix
+		// Convert the key to lowercase, remove the prefix, and split on the
+		// separator to form a dotted, hierarchical config key.
 		configKey := strings.ToLower(strings.TrimPrefix(key, prefix))
-		// Replace underscores with dots for hierarchical config
-		configKey = strings.ReplaceAll(configKey, "_", ".")
-
-		// Try to parse the value as different types
-		if value == "true" || value == "false" {
-			values[configKey] = value == "true"
-		} else if intVal, err := parseInt(value); err == nil {
-			values[configKey] = intVal
-		} else if floatVal, err := parseFloat(value); err == nil {
-			values[configKey] = floatVal
-		} else {
-			values[configKey] = value
-		}
+		configKey = strings.ReplaceAll(configKey, separator, pathSeparator)
+
+		setPath(values, configKey, coerceScalar(value))
 	}
 
 	return values, nil
@@ -87,20 +161,58 @@ type DefaultSource struct {
 	Values map[string]any
 }
 
+// String implements fmt.Stringer, used when reporting a key's Origin.
+func (s *DefaultSource) String() string {
+	return "DefaultSource"
+}
+
 // Load implements the Source interface
 func (s *DefaultSource) Load() (map[string]any, error) {
 	return s.Values, nil
 }
 
-// Helper functions for parsing values
+// coerceScalar converts a raw string value (from an env var or a dotenv
+// file) into a richer type when it looks like one: a JSON object/array
+// (leading '{' or '['), a bool, an int, a float64, or a []string for a
+// comma-separated list. It falls back to the original string otherwise.
+func coerceScalar(value string) any {
+	if trimmed := strings.TrimSpace(value); strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		var decoded any
+		if err := json.Unmarshal([]byte(trimmed), &decoded); err == nil {
+			return decoded
+		}
+	}
+	if value == "true" || value == "false" {
+		return value == "true"
+	}
+	if intVal, err := parseInt(value); err == nil {
+		return intVal
+	}
+	if floatVal, err := parseFloat(value); err == nil {
+		return floatVal
+	}
+	if strings.Contains(value, ",") {
+		parts := strings.Split(value, ",")
+		list := make([]string, len(parts))
+		for i, part := range parts {
+			list[i] = strings.TrimSpace(part)
+		}
+		return list
+	}
+	return value
+}
+
+// Helper functions for parsing values. Unlike fmt.Sscanf, strconv's parsers
+// require the entire string to be consumed, so a value like "42abc" is
+// correctly rejected rather than silently truncated to 42.
 func parseInt(s string) (int, error) {
-	var i int
-	_, err := fmt.Sscanf(s, "%d", &i)
-	return i, err
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return int(i), nil
 }
 
 func parseFloat(s string) (float64, error) {
-	var f float64
-	_, err := fmt.Sscanf(s, "%f", &f)
-	return f, err
+	return strconv.ParseFloat(s, 64)
 }
@@ -0,0 +1,70 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MichaelAJay/go-config"
+)
+
+func TestFileSource_TOML(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.toml")
+	content := "port = 8080\nhost = \"localhost\"\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("write config file: %v", err)
+	}
+
+	src := &config.FileSource{Path: path}
+	values, err := src.Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if values["port"] != int64(8080) {
+		t.Errorf("port: got %v (%T), want 8080", values["port"], values["port"])
+	}
+	if values["host"] != "localhost" {
+		t.Errorf("host: got %v, want %v", values["host"], "localhost")
+	}
+
+	cfg := config.New()
+	if err := cfg.Load(src); err != nil {
+		t.Fatalf("ConfigManager.Load failed: %v", err)
+	}
+	if val, ok := cfg.GetInt("port"); !ok || val != 8080 {
+		t.Errorf("GetInt: got %v, %v, want %v, %v", val, ok, 8080, true)
+	}
+	if val, ok := cfg.GetFloat("port"); !ok || val != 8080.0 {
+		t.Errorf("GetFloat: got %v, %v, want %v, %v", val, ok, 8080.0, true)
+	}
+}
+
+func TestFileSource_DotEnv(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.env")
+	content := "# a comment\nexport PORT=8080\nHOST=\"localhost\"\nDEBUG=true\nNAME=config-service # trailing comment\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("write config file: %v", err)
+	}
+
+	src := &config.FileSource{Path: path}
+	values, err := src.Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if values["port"] != 8080 {
+		t.Errorf("port: got %v (%T), want 8080", values["port"], values["port"])
+	}
+	if values["host"] != "localhost" {
+		t.Errorf("host: got %v, want %v", values["host"], "localhost")
+	}
+	if values["debug"] != true {
+		t.Errorf("debug: got %v, want true", values["debug"])
+	}
+	if values["name"] != "config-service" {
+		t.Errorf("name: got %q, want %q", values["name"], "config-service")
+	}
+}
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+)
+
+// pathSeparator splits dotted keys like "server.http.port" into path segments.
+const pathSeparator = "."
+
+// splitPath splits a dotted key into its path segments.
+func splitPath(key string) []string {
+	return strings.Split(key, pathSeparator)
+}
+
+// getPath walks a dotted key through nested map[string]any (and []any for
+// numeric segments) values and returns the leaf value it finds.
+func getPath(values map[string]any, key string) (any, bool) {
+	parts := splitPath(key)
+
+	var current any = values
+	for _, part := range parts {
+		switch node := current.(type) {
+		case map[string]any:
+			value, exists := node[part]
+			if !exists {
+				return nil, false
+			}
+			current = value
+		case []any:
+			index, err := strconv.Atoi(part)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, false
+			}
+			current = node[index]
+		default:
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+// setPath walks a dotted key through values, creating intermediate
+// map[string]any nodes as needed, and assigns value at the leaf.
+func setPath(values map[string]any, key string, value any) {
+	parts := splitPath(key)
+
+	node := values
+	for _, part := range parts[:len(parts)-1] {
+		next, exists := node[part]
+		if !exists {
+			newNode := make(map[string]any)
+			node[part] = newNode
+			node = newNode
+			continue
+		}
+
+		nextMap, ok := next.(map[string]any)
+		if !ok {
+			nextMap = make(map[string]any)
+			node[part] = nextMap
+		}
+		node = nextMap
+	}
+
+	node[parts[len(parts)-1]] = value
+}
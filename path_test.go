@@ -0,0 +1,55 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/MichaelAJay/go-config"
+)
+
+func TestConfigManager_NestedKeys(t *testing.T) {
+	cfg := config.New()
+
+	err := cfg.Set("server.http.port", 8080)
+	if err != nil {
+		t.Errorf("Set failed: %v", err)
+	}
+	if val, ok := cfg.GetInt("server.http.port"); !ok || val != 8080 {
+		t.Errorf("GetInt failed: got %v, %v, want %v, %v", val, ok, 8080, true)
+	}
+
+	// Setting a sibling key should not disturb the existing nested structure
+	err = cfg.Set("server.http.host", "localhost")
+	if err != nil {
+		t.Errorf("Set failed: %v", err)
+	}
+	if val, ok := cfg.GetString("server.http.host"); !ok || val != "localhost" {
+		t.Errorf("GetString failed: got %v, %v, want %v, %v", val, ok, "localhost", true)
+	}
+	if val, ok := cfg.GetInt("server.http.port"); !ok || val != 8080 {
+		t.Errorf("GetInt failed after sibling set: got %v, %v, want %v, %v", val, ok, 8080, true)
+	}
+}
+
+func TestConfigManager_NestedKeys_FromLoadedSource(t *testing.T) {
+	cfg := config.New()
+
+	source := &MockSource{
+		values: map[string]any{
+			"server": map[string]any{
+				"http": map[string]any{
+					"port": 9090,
+				},
+			},
+		},
+	}
+	if err := cfg.Load(source); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if val, ok := cfg.GetInt("server.http.port"); !ok || val != 9090 {
+		t.Errorf("GetInt failed: got %v, %v, want %v, %v", val, ok, 9090, true)
+	}
+	if _, ok := cfg.Get("server.http.missing"); ok {
+		t.Error("Get should return false for a missing nested key")
+	}
+}
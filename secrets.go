@@ -0,0 +1,117 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// SecretProvider resolves a scheme-specific reference (the part after
+// "<scheme>://") to its real value. Implementations may call out to cloud
+// secret managers, vaults, or any other backing store without this module
+// depending on their SDKs.
+type SecretProvider interface {
+	Retrieve(ctx context.Context, ref string) (string, error)
+}
+
+// RegisterResolver associates scheme (e.g. "env", "file", "vault") with the
+// SecretProvider that should resolve references using it, such as
+// "env://DB_PASSWORD" or "vault://secret/data/db#password".
+func (c *ConfigManager) RegisterResolver(scheme string, provider SecretProvider) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.resolvers == nil {
+		c.resolvers = make(map[string]SecretProvider)
+	}
+	c.resolvers[scheme] = provider
+}
+
+// ResolveSecrets walks every string leaf value in the configuration and, for
+// any value of the form "<scheme>://<ref>" with a registered resolver,
+// replaces it with the value the resolver retrieves. It is typically called
+// once after Load (or Reload) and before Validate.
+func (c *ConfigManager) ResolveSecrets(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return resolveSecretRefs(ctx, c.values, c.resolvers)
+}
+
+// resolveSecretRefs mutates values in place, descending into nested maps
+// and slices.
+func resolveSecretRefs(ctx context.Context, values map[string]any, resolvers map[string]SecretProvider) error {
+	for k, v := range values {
+		switch val := v.(type) {
+		case string:
+			scheme, ref, ok := splitSecretRef(val)
+			if !ok {
+				continue
+			}
+			provider, ok := resolvers[scheme]
+			if !ok {
+				continue
+			}
+			resolved, err := provider.Retrieve(ctx, ref)
+			if err != nil {
+				return fmt.Errorf("failed to resolve secret %q for key %s: %w", val, k, err)
+			}
+			values[k] = resolved
+		case map[string]any:
+			if err := resolveSecretRefs(ctx, val, resolvers); err != nil {
+				return err
+			}
+		case []any:
+			resolvedItems := make([]any, len(val))
+			for i, item := range val {
+				wrapped := map[string]any{k: item}
+				if err := resolveSecretRefs(ctx, wrapped, resolvers); err != nil {
+					return err
+				}
+				resolvedItems[i] = wrapped[k]
+			}
+			values[k] = resolvedItems
+		}
+	}
+
+	return nil
+}
+
+// splitSecretRef splits a value of the form "<scheme>://<ref>" into its
+// scheme and ref. It returns ok=false for values that do not look like a
+// secret reference.
+func splitSecretRef(value string) (scheme, ref string, ok bool) {
+	scheme, rest, found := strings.Cut(value, "://")
+	if !found || scheme == "" {
+		return "", "", false
+	}
+	return scheme, rest, true
+}
+
+// EnvResolver resolves "env://VAR_NAME" references from the process
+// environment.
+type EnvResolver struct{}
+
+// Retrieve implements SecretProvider.
+func (EnvResolver) Retrieve(_ context.Context, ref string) (string, error) {
+	value, ok := os.LookupEnv(ref)
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", ref)
+	}
+	return value, nil
+}
+
+// FileResolver resolves "file:///path/to/secret" references by reading the
+// referenced file's contents, trimming a single trailing newline (the
+// common convention for secrets mounted by orchestrators like Kubernetes).
+type FileResolver struct{}
+
+// Retrieve implements SecretProvider.
+func (FileResolver) Retrieve(_ context.Context, ref string) (string, error) {
+	data, err := os.ReadFile(ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret file %s: %w", ref, err)
+	}
+	return strings.TrimSuffix(string(data), "\n"), nil
+}
@@ -0,0 +1,84 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MichaelAJay/go-config"
+)
+
+func TestConfigManager_ResolveSecrets_Env(t *testing.T) {
+	t.Setenv("DB_PASSWORD", "hunter2")
+
+	cfg := config.New()
+	source := &MockSource{
+		values: map[string]any{
+			"db": map[string]any{
+				"password": "env://DB_PASSWORD",
+				"host":     "localhost",
+			},
+		},
+	}
+	if err := cfg.Load(source); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	cfg.RegisterResolver("env", config.EnvResolver{})
+	if err := cfg.ResolveSecrets(context.Background()); err != nil {
+		t.Fatalf("ResolveSecrets failed: %v", err)
+	}
+
+	if val, ok := cfg.GetString("db.password"); !ok || val != "hunter2" {
+		t.Errorf("db.password: got %v, %v, want %v, %v", val, ok, "hunter2", true)
+	}
+	if val, ok := cfg.GetString("db.host"); !ok || val != "localhost" {
+		t.Errorf("db.host should be untouched: got %v, %v", val, ok)
+	}
+}
+
+func TestConfigManager_ResolveSecrets_File(t *testing.T) {
+	dir := t.TempDir()
+	secretPath := filepath.Join(dir, "api_key")
+	if err := os.WriteFile(secretPath, []byte("s3cr3t\n"), 0o600); err != nil {
+		t.Fatalf("write secret file: %v", err)
+	}
+
+	cfg := config.New()
+	source := &MockSource{
+		values: map[string]any{
+			"api_key": "file://" + secretPath,
+		},
+	}
+	if err := cfg.Load(source); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	cfg.RegisterResolver("file", config.FileResolver{})
+	if err := cfg.ResolveSecrets(context.Background()); err != nil {
+		t.Fatalf("ResolveSecrets failed: %v", err)
+	}
+
+	if val, ok := cfg.GetString("api_key"); !ok || val != "s3cr3t" {
+		t.Errorf("api_key: got %v, %v, want %v, %v", val, ok, "s3cr3t", true)
+	}
+}
+
+func TestConfigManager_ResolveSecrets_UnregisteredSchemeUntouched(t *testing.T) {
+	cfg := config.New()
+	source := &MockSource{
+		values: map[string]any{"secret": "vault://secret/data/db"},
+	}
+	if err := cfg.Load(source); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if err := cfg.ResolveSecrets(context.Background()); err != nil {
+		t.Fatalf("ResolveSecrets failed: %v", err)
+	}
+
+	if val, ok := cfg.GetString("secret"); !ok || val != "vault://secret/data/db" {
+		t.Errorf("secret should be left untouched without a registered resolver: got %v, %v", val, ok)
+	}
+}
@@ -0,0 +1,122 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+)
+
+// originExplicitSet is recorded for keys set via ConfigManager.Set.
+const originExplicitSet = "explicit Set"
+
+// registeredSource pairs a Source with its precedence in the overlay chain.
+// Higher priority sources are applied later and win on key conflicts.
+type registeredSource struct {
+	source   Source
+	priority int
+}
+
+// AddSource registers src in the manager's overlay chain at the given
+// priority and immediately reloads the chain so its values take effect.
+// Sources are applied in ascending priority order, so a higher priority
+// source overwrites leaf keys supplied by a lower priority one (e.g.
+// defaults at priority 0, a file at 10, env at 20).
+func (c *ConfigManager) AddSource(src Source, priority int) error {
+	c.mu.Lock()
+	c.sources = append(c.sources, &registeredSource{source: src, priority: priority})
+	sort.SliceStable(c.sources, func(i, j int) bool {
+		return c.sources[i].priority < c.sources[j].priority
+	})
+	c.mu.Unlock()
+
+	return c.Reload()
+}
+
+// Reload re-reads every source registered via AddSource, deep-merges them in
+// priority order, re-applies any values set directly via Set, and swaps the
+// result in atomically. Existing Load'ed values not backed by a registered
+// source are discarded.
+func (c *ConfigManager) Reload() error {
+	c.mu.Lock()
+	sources := make([]*registeredSource, len(c.sources))
+	copy(sources, c.sources)
+	c.mu.Unlock()
+
+	values := make(map[string]any)
+	origins := make(map[string]string)
+
+	for _, reg := range sources {
+		loaded, err := reg.source.Load()
+		if err != nil {
+			return fmt.Errorf("failed to reload configuration from %s: %w", sourceName(reg.source), err)
+		}
+		deepMerge(values, loaded, "", origins, sourceName(reg.source))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	deepMerge(values, c.overrides, "", origins, originExplicitSet)
+
+	c.values = values
+	c.origins = origins
+
+	return nil
+}
+
+// Origin reports which source last supplied the value for key, e.g.
+// "EnvSource(APP_)" or "explicit Set". It returns an empty string if no
+// Load, AddSource, Set or BindEnv has supplied the key.
+func (c *ConfigManager) Origin(key string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.origins[key]
+}
+
+// SetErrorOnUnmatchedKeys controls whether Unmarshal and UnmarshalKey fail
+// when a decoded map contains keys not mapped to any destination struct
+// field, mirroring configor's strict mode.
+func (c *ConfigManager) SetErrorOnUnmatchedKeys(enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.strict = enabled
+}
+
+// sourceName returns a human-readable identifier for a Source, used when
+// recording key origins. Sources that implement fmt.Stringer are asked
+// directly; otherwise the Go type name is used.
+func sourceName(source Source) string {
+	if named, ok := source.(fmt.Stringer); ok {
+		return named.String()
+	}
+	return fmt.Sprintf("%T", source)
+}
+
+// deepMerge merges src into dst in place, recursing into nested
+// map[string]any values and overwriting leaf values otherwise. Every leaf
+// key written is recorded in origins (keyed by its full dotted path) as
+// having come from originName.
+func deepMerge(dst, src map[string]any, prefix string, origins map[string]string, originName string) {
+	for k, v := range src {
+		fullKey := k
+		if prefix != "" {
+			fullKey = prefix + pathSeparator + k
+		}
+
+		if srcMap, ok := v.(map[string]any); ok {
+			dstMap, ok := dst[k].(map[string]any)
+			if !ok {
+				dstMap = make(map[string]any)
+				dst[k] = dstMap
+			}
+			deepMerge(dstMap, srcMap, fullKey, origins, originName)
+			continue
+		}
+
+		dst[k] = v
+		if origins != nil {
+			origins[fullKey] = originName
+		}
+	}
+}
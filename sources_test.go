@@ -0,0 +1,84 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/MichaelAJay/go-config"
+)
+
+func TestConfigManager_AddSource_Precedence(t *testing.T) {
+	cfg := config.New()
+
+	defaults := &MockSource{values: map[string]any{"server": map[string]any{"port": 8080}}}
+	override := &MockSource{values: map[string]any{"server": map[string]any{"port": 9090}}}
+
+	if err := cfg.AddSource(defaults, 0); err != nil {
+		t.Fatalf("AddSource(defaults) failed: %v", err)
+	}
+	if err := cfg.AddSource(override, 10); err != nil {
+		t.Fatalf("AddSource(override) failed: %v", err)
+	}
+
+	if val, ok := cfg.GetInt("server.port"); !ok || val != 9090 {
+		t.Errorf("GetInt: got %v, %v, want %v, %v", val, ok, 9090, true)
+	}
+}
+
+func TestConfigManager_Reload(t *testing.T) {
+	cfg := config.New()
+
+	source := &MockSource{values: map[string]any{"port": 8080}}
+	if err := cfg.AddSource(source, 0); err != nil {
+		t.Fatalf("AddSource failed: %v", err)
+	}
+
+	source.values = map[string]any{"port": 9090}
+	if err := cfg.Reload(); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+
+	if val, ok := cfg.GetInt("port"); !ok || val != 9090 {
+		t.Errorf("GetInt after reload: got %v, %v, want %v, %v", val, ok, 9090, true)
+	}
+}
+
+func TestConfigManager_Reload_PreservesExplicitSet(t *testing.T) {
+	cfg := config.New()
+
+	source := &MockSource{values: map[string]any{"port": 8080}}
+	if err := cfg.AddSource(source, 0); err != nil {
+		t.Fatalf("AddSource failed: %v", err)
+	}
+	if err := cfg.Set("port", 1111); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+
+	if err := cfg.Reload(); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+
+	if val, ok := cfg.GetInt("port"); !ok || val != 1111 {
+		t.Errorf("GetInt after reload: got %v, %v, want %v, %v", val, ok, 1111, true)
+	}
+}
+
+func TestConfigManager_Origin(t *testing.T) {
+	cfg := config.New()
+
+	fileSrc := &config.FileSource{Path: "config.yaml"}
+	if cfg.Origin("missing") != "" {
+		t.Error("Origin should be empty for an untracked key")
+	}
+
+	_ = fileSrc // used only to document the expected String() format below
+	if got := fileSrc.String(); got != "FileSource(config.yaml)" {
+		t.Errorf("FileSource.String(): got %q", got)
+	}
+
+	if err := cfg.Set("explicit", "value"); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if got := cfg.Origin("explicit"); got != "explicit Set" {
+		t.Errorf("Origin: got %q, want %q", got, "explicit Set")
+	}
+}
@@ -0,0 +1,348 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// configTag is the struct tag used to map a field to a configuration key.
+const configTag = "config"
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// Unmarshal decodes the entire configuration tree into dst, which must be a
+// pointer to a struct. Fields are mapped using the `config:"..."` tag
+// (dotted paths are supported); a `default:"..."` tag supplies a value when
+// the key is absent, and `required:"true"` causes a missing key to be an
+// error.
+func (c *ConfigManager) Unmarshal(dst any) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return decodeStruct(c.values, dst, c.strict)
+}
+
+// UnmarshalKey decodes the configuration subtree rooted at key into dst,
+// following the same tag conventions as Unmarshal.
+func (c *ConfigManager) UnmarshalKey(key string, dst any) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	value, exists := getPath(c.values, key)
+	if !exists {
+		return decodeStruct(map[string]any{}, dst, c.strict)
+	}
+
+	data, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("config key %s is not a map and cannot be unmarshaled", key)
+	}
+
+	return decodeStruct(data, dst, c.strict)
+}
+
+// decodeStruct maps data onto the struct pointed to by dst. If strict is
+// true, any key in data that no struct field maps to causes an error
+// (ErrorOnUnmatchedKeys, see ConfigManager.SetErrorOnUnmatchedKeys).
+func decodeStruct(data map[string]any, dst any, strict bool) error {
+	destVal := reflect.ValueOf(dst)
+	if destVal.Kind() != reflect.Pointer || destVal.IsNil() || destVal.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("unmarshal destination must be a non-nil pointer to a struct")
+	}
+
+	structVal := destVal.Elem()
+	structType := structVal.Type()
+	matched := make(map[string]bool, structType.NumField())
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		key := field.Tag.Get(configTag)
+		if key == "" {
+			key = strings.ToLower(field.Name)
+		}
+		matched[splitPath(key)[0]] = true
+
+		fieldVal := structVal.Field(i)
+		value, exists := getPath(data, key)
+
+		if !exists {
+			if def, hasDefault := field.Tag.Lookup("default"); hasDefault {
+				if err := setFieldFromString(fieldVal, def); err != nil {
+					return fmt.Errorf("config key %s: %w", key, err)
+				}
+				continue
+			}
+			if field.Tag.Get("required") == "true" {
+				return fmt.Errorf("required configuration key missing: %s", key)
+			}
+			if fieldVal.Kind() == reflect.Struct && fieldVal.Type() != timeType {
+				if err := decodeStruct(map[string]any{}, fieldVal.Addr().Interface(), strict); err != nil {
+					return fmt.Errorf("config key %s: %w", key, err)
+				}
+			}
+			continue
+		}
+
+		if err := setField(fieldVal, value, strict); err != nil {
+			return fmt.Errorf("config key %s: %w", key, err)
+		}
+	}
+
+	if strict {
+		var unmatched []string
+		for k := range data {
+			if !matched[k] {
+				unmatched = append(unmatched, k)
+			}
+		}
+		if len(unmatched) > 0 {
+			sort.Strings(unmatched)
+			return fmt.Errorf("unmatched configuration keys: %s", strings.Join(unmatched, ", "))
+		}
+	}
+
+	return nil
+}
+
+// setField assigns value to fieldVal, coercing between compatible types and
+// recursing into nested structs and slices.
+func setField(fieldVal reflect.Value, value any, strict bool) error {
+	switch fieldVal.Type() {
+	case durationType:
+		d, err := toDuration(value)
+		if err != nil {
+			return err
+		}
+		fieldVal.Set(reflect.ValueOf(d))
+		return nil
+	case timeType:
+		t, err := toTime(value)
+		if err != nil {
+			return err
+		}
+		fieldVal.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch fieldVal.Kind() {
+	case reflect.Struct:
+		data, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("expected a map to decode into %s, got %T", fieldVal.Type(), value)
+		}
+		return decodeStruct(data, fieldVal.Addr().Interface(), strict)
+	case reflect.Pointer:
+		if fieldVal.IsNil() {
+			fieldVal.Set(reflect.New(fieldVal.Type().Elem()))
+		}
+		return setField(fieldVal.Elem(), value, strict)
+	case reflect.Slice:
+		return setSlice(fieldVal, value, strict)
+	case reflect.String:
+		s, err := toString(value)
+		if err != nil {
+			return err
+		}
+		fieldVal.SetString(s)
+		return nil
+	case reflect.Bool:
+		b, err := toBool(value)
+		if err != nil {
+			return err
+		}
+		fieldVal.SetBool(b)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := toInt64(value)
+		if err != nil {
+			return err
+		}
+		fieldVal.SetInt(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := toFloat64(value)
+		if err != nil {
+			return err
+		}
+		fieldVal.SetFloat(f)
+		return nil
+	default:
+		return fmt.Errorf("unsupported field kind %s", fieldVal.Kind())
+	}
+}
+
+// setSlice decodes value into a slice field, coercing each element.
+func setSlice(fieldVal reflect.Value, value any, strict bool) error {
+	items, ok := value.([]any)
+	if !ok {
+		return fmt.Errorf("expected a slice, got %T", value)
+	}
+
+	result := reflect.MakeSlice(fieldVal.Type(), len(items), len(items))
+	for i, item := range items {
+		if err := setField(result.Index(i), item, strict); err != nil {
+			return fmt.Errorf("index %d: %w", i, err)
+		}
+	}
+
+	fieldVal.Set(result)
+	return nil
+}
+
+// setFieldFromString applies a raw default-tag string to fieldVal.
+func setFieldFromString(fieldVal reflect.Value, raw string) error {
+	switch fieldVal.Type() {
+	case durationType:
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("invalid default duration %q: %w", raw, err)
+		}
+		fieldVal.Set(reflect.ValueOf(d))
+		return nil
+	case timeType:
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return fmt.Errorf("invalid default time %q: %w", raw, err)
+		}
+		fieldVal.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch fieldVal.Kind() {
+	case reflect.String:
+		fieldVal.SetString(raw)
+		return nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("invalid default bool %q: %w", raw, err)
+		}
+		fieldVal.SetBool(b)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid default int %q: %w", raw, err)
+		}
+		fieldVal.SetInt(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("invalid default float %q: %w", raw, err)
+		}
+		fieldVal.SetFloat(f)
+		return nil
+	default:
+		return fmt.Errorf("unsupported field kind %s for default tag", fieldVal.Kind())
+	}
+}
+
+// The to* helpers mirror the coercion tolerance of GetInt/GetFloat/GetBool,
+// additionally accepting string representations so values loaded from env
+// or file sources decode cleanly regardless of their original type.
+
+func toString(value any) (string, error) {
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	return "", fmt.Errorf("expected a string, got %T", value)
+}
+
+func toBool(value any) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, fmt.Errorf("expected a bool, got %q", v)
+		}
+		return b, nil
+	default:
+		return false, fmt.Errorf("expected a bool, got %T", value)
+	}
+}
+
+func toInt64(value any) (int64, error) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case float64:
+		return int64(v), nil
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("expected an int, got %q", v)
+		}
+		return n, nil
+	default:
+		return 0, fmt.Errorf("expected an int, got %T", value)
+	}
+}
+
+func toFloat64(value any) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("expected a float, got %q", v)
+		}
+		return f, nil
+	default:
+		return 0, fmt.Errorf("expected a float, got %T", value)
+	}
+}
+
+func toDuration(value any) (time.Duration, error) {
+	switch v := value.(type) {
+	case time.Duration:
+		return v, nil
+	case string:
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return 0, fmt.Errorf("expected a duration, got %q", v)
+		}
+		return d, nil
+	case int:
+		return time.Duration(v), nil
+	case float64:
+		return time.Duration(v), nil
+	default:
+		return 0, fmt.Errorf("expected a duration, got %T", value)
+	}
+}
+
+func toTime(value any) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("expected an RFC3339 timestamp, got %q", v)
+		}
+		return t, nil
+	default:
+		return time.Time{}, fmt.Errorf("expected a time, got %T", value)
+	}
+}
@@ -0,0 +1,98 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MichaelAJay/go-config"
+)
+
+type httpConfig struct {
+	Port int    `config:"port"`
+	Host string `config:"host" default:"0.0.0.0"`
+}
+
+type serverConfig struct {
+	HTTP    httpConfig    `config:"http"`
+	Timeout time.Duration `config:"timeout" default:"5s"`
+	Tags    []string      `config:"tags"`
+}
+
+type appConfig struct {
+	Server serverConfig `config:"server"`
+	Name   string       `config:"name" required:"true"`
+}
+
+func TestConfigManager_Unmarshal(t *testing.T) {
+	cfg := config.New()
+
+	source := &MockSource{
+		values: map[string]any{
+			"name": "my-app",
+			"server": map[string]any{
+				"http": map[string]any{
+					"port": 8080,
+				},
+				"tags": []any{"a", "b"},
+			},
+		},
+	}
+	if err := cfg.Load(source); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	var app appConfig
+	if err := cfg.Unmarshal(&app); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	if app.Name != "my-app" {
+		t.Errorf("Name: got %q, want %q", app.Name, "my-app")
+	}
+	if app.Server.HTTP.Port != 8080 {
+		t.Errorf("Server.HTTP.Port: got %d, want %d", app.Server.HTTP.Port, 8080)
+	}
+	if app.Server.HTTP.Host != "0.0.0.0" {
+		t.Errorf("Server.HTTP.Host default: got %q, want %q", app.Server.HTTP.Host, "0.0.0.0")
+	}
+	if app.Server.Timeout != 5*time.Second {
+		t.Errorf("Server.Timeout default: got %v, want %v", app.Server.Timeout, 5*time.Second)
+	}
+	if len(app.Server.Tags) != 2 || app.Server.Tags[0] != "a" || app.Server.Tags[1] != "b" {
+		t.Errorf("Server.Tags: got %v", app.Server.Tags)
+	}
+}
+
+func TestConfigManager_Unmarshal_RequiredMissing(t *testing.T) {
+	cfg := config.New()
+
+	var app appConfig
+	if err := cfg.Unmarshal(&app); err == nil {
+		t.Error("expected an error for missing required field, got nil")
+	}
+}
+
+func TestConfigManager_UnmarshalKey(t *testing.T) {
+	cfg := config.New()
+
+	source := &MockSource{
+		values: map[string]any{
+			"server": map[string]any{
+				"http": map[string]any{
+					"port": 9090,
+				},
+			},
+		},
+	}
+	if err := cfg.Load(source); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	var srv serverConfig
+	if err := cfg.UnmarshalKey("server", &srv); err != nil {
+		t.Fatalf("UnmarshalKey failed: %v", err)
+	}
+	if srv.HTTP.Port != 9090 {
+		t.Errorf("HTTP.Port: got %d, want %d", srv.HTTP.Port, 9090)
+	}
+}
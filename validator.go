@@ -18,7 +18,7 @@ type RequiredValidator struct {
 // Validate implements the Validator interface
 func (v *RequiredValidator) Validate(values map[string]any) error {
 	for _, key := range v.Keys {
-		if _, exists := values[key]; !exists {
+		if _, exists := getPath(values, key); !exists {
 			return fmt.Errorf("required configuration key missing: %s", key)
 		}
 	}
@@ -33,7 +33,7 @@ type TypeValidator struct {
 
 // Validate implements the Validator interface
 func (v *TypeValidator) Validate(values map[string]any) error {
-	value, exists := values[v.Key]
+	value, exists := getPath(values, v.Key)
 	if !exists {
 		return nil // Skip validation if key doesn't exist
 	}
@@ -55,7 +55,7 @@ type RangeValidator struct {
 
 // Validate implements the Validator interface
 func (v *RangeValidator) Validate(values map[string]any) error {
-	value, exists := values[v.Key]
+	value, exists := getPath(values, v.Key)
 	if !exists {
 		return nil // Skip validation if key doesn't exist
 	}
@@ -0,0 +1,44 @@
+package config_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/MichaelAJay/go-config"
+)
+
+func TestRequiredValidator_NestedKey(t *testing.T) {
+	cfg := config.New()
+	if err := cfg.Set("server.http.port", 8080); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+
+	cfg.AddValidator(&config.RequiredValidator{Keys: []string{"server.http.port"}})
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate failed for a present nested key: %v", err)
+	}
+}
+
+func TestTypeValidator_NestedKey(t *testing.T) {
+	cfg := config.New()
+	if err := cfg.Set("server.http.port", 8080); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+
+	cfg.AddValidator(&config.TypeValidator{Key: "server.http.port", Type: reflect.TypeOf(0)})
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate failed for a present nested key: %v", err)
+	}
+}
+
+func TestRangeValidator_NestedKey(t *testing.T) {
+	cfg := config.New()
+	if err := cfg.Set("server.http.port", 8080); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+
+	cfg.AddValidator(&config.RangeValidator{Key: "server.http.port", Min: 1, Max: 65535, IsInt: true})
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate failed for a present nested key: %v", err)
+	}
+}
@@ -0,0 +1,183 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// watchDebounceInterval coalesces rapid successive change notifications
+// (e.g. an editor performing several writes while saving) into one reload.
+const watchDebounceInterval = 200 * time.Millisecond
+
+// Watchable is implemented by Sources that can notify a callback when their
+// underlying data changes. Watch should invoke onChange whenever the
+// source's data may have changed and return a stop function to release any
+// resources (e.g. a file watcher) once the caller is done.
+type Watchable interface {
+	Source
+	Watch(onChange func()) (stop func() error, err error)
+}
+
+// WatchSource enables hot reload for src: whenever src reports a change, its
+// values are reloaded and deep-merged on top of the current configuration,
+// all registered Validators are re-run against the result, and the swap is
+// applied only if validation passes. A failing reload leaves the existing
+// configuration untouched. src must implement Watchable.
+func (c *ConfigManager) WatchSource(src Source) error {
+	watchable, ok := src.(Watchable)
+	if !ok {
+		return fmt.Errorf("source %s does not support watching", sourceName(src))
+	}
+
+	onChange := debounce(func() { c.reloadWatchedSource(src) }, watchDebounceInterval)
+
+	stop, err := watchable.Watch(onChange)
+	if err != nil {
+		return fmt.Errorf("failed to watch %s: %w", sourceName(src), err)
+	}
+
+	c.mu.Lock()
+	c.watchStops = append(c.watchStops, stop)
+	c.mu.Unlock()
+
+	return nil
+}
+
+// OnChange registers a callback invoked for every leaf key whose value
+// changes as the result of a watched source reloading.
+func (c *ConfigManager) OnChange(fn func(key string, oldVal, newVal any)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.onChangeFuncs = append(c.onChangeFuncs, fn)
+}
+
+// StopWatching stops every watcher started via WatchSource.
+func (c *ConfigManager) StopWatching() error {
+	c.mu.Lock()
+	stops := c.watchStops
+	c.watchStops = nil
+	c.mu.Unlock()
+
+	var firstErr error
+	for _, stop := range stops {
+		if err := stop(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// reloadWatchedSource re-reads src, validates the merged result, and
+// atomically swaps it in if (and only if) validation passes.
+func (c *ConfigManager) reloadWatchedSource(src Source) {
+	loaded, err := src.Load()
+	if err != nil {
+		return
+	}
+
+	c.mu.RLock()
+	candidate := deepCopyMap(c.values)
+	validators := make([]Validator, len(c.validators))
+	copy(validators, c.validators)
+	c.mu.RUnlock()
+
+	deepMerge(candidate, loaded, "", nil, "")
+
+	for _, validator := range validators {
+		if err := validator.Validate(candidate); err != nil {
+			return // rollback: keep the previously active configuration
+		}
+	}
+
+	c.mu.Lock()
+	old := c.values
+	c.values = candidate
+	callbacks := make([]func(string, any, any), len(c.onChangeFuncs))
+	copy(callbacks, c.onChangeFuncs)
+	c.mu.Unlock()
+
+	notifyChanges(old, candidate, "", callbacks)
+}
+
+// deepCopyMap returns a deep copy of a nested map[string]any tree so
+// speculative merges can be validated before being swapped in.
+func deepCopyMap(src map[string]any) map[string]any {
+	dst := make(map[string]any, len(src))
+	for k, v := range src {
+		if nested, ok := v.(map[string]any); ok {
+			dst[k] = deepCopyMap(nested)
+			continue
+		}
+		dst[k] = v
+	}
+	return dst
+}
+
+// notifyChanges walks old and updated in lockstep and invokes callbacks for
+// every leaf key whose value differs between them.
+func notifyChanges(old, updated map[string]any, prefix string, callbacks []func(string, any, any)) {
+	if len(callbacks) == 0 {
+		return
+	}
+
+	seen := make(map[string]bool, len(updated))
+	for k, newVal := range updated {
+		seen[k] = true
+		fullKey := k
+		if prefix != "" {
+			fullKey = prefix + pathSeparator + k
+		}
+
+		oldVal := old[k]
+		newMap, newIsMap := newVal.(map[string]any)
+		oldMap, oldIsMap := oldVal.(map[string]any)
+
+		switch {
+		case newIsMap && oldIsMap:
+			notifyChanges(oldMap, newMap, fullKey, callbacks)
+		case newIsMap != oldIsMap:
+			fireOnChange(callbacks, fullKey, oldVal, newVal)
+		default:
+			if !reflect.DeepEqual(oldVal, newVal) {
+				fireOnChange(callbacks, fullKey, oldVal, newVal)
+			}
+		}
+	}
+
+	for k, oldVal := range old {
+		if seen[k] {
+			continue
+		}
+		fullKey := k
+		if prefix != "" {
+			fullKey = prefix + pathSeparator + k
+		}
+		fireOnChange(callbacks, fullKey, oldVal, nil)
+	}
+}
+
+func fireOnChange(callbacks []func(string, any, any), key string, oldVal, newVal any) {
+	for _, cb := range callbacks {
+		cb(key, oldVal, newVal)
+	}
+}
+
+// debounce returns a function that, however many times it is called in
+// quick succession, invokes fn only once interval after the last call.
+func debounce(fn func(), interval time.Duration) func() {
+	var mu sync.Mutex
+	var timer *time.Timer
+
+	return func() {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = time.AfterFunc(interval, fn)
+	}
+}
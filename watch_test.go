@@ -0,0 +1,103 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/MichaelAJay/go-config"
+)
+
+func TestConfigManager_WatchSource_HotReload(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(path, []byte("port: 8080\n"), 0o644); err != nil {
+		t.Fatalf("write config file: %v", err)
+	}
+
+	cfg := config.New()
+	src := &config.FileSource{Path: path}
+	if err := cfg.Load(src); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	changed := make(chan struct{}, 1)
+	cfg.OnChange(func(key string, oldVal, newVal any) {
+		if key == "port" {
+			select {
+			case changed <- struct{}{}:
+			default:
+			}
+		}
+	})
+
+	if err := cfg.WatchSource(src); err != nil {
+		t.Fatalf("WatchSource failed: %v", err)
+	}
+	defer cfg.StopWatching()
+
+	if err := os.WriteFile(path, []byte("port: 9090\n"), 0o644); err != nil {
+		t.Fatalf("rewrite config file: %v", err)
+	}
+
+	select {
+	case <-changed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for OnChange callback")
+	}
+
+	if val, ok := cfg.GetInt("port"); !ok || val != 9090 {
+		t.Errorf("GetInt after hot reload: got %v, %v, want %v, %v", val, ok, 9090, true)
+	}
+}
+
+func TestConfigManager_WatchSource_SliceLeafChangeDoesNotPanic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(path, []byte("tags: [a, b]\n"), 0o644); err != nil {
+		t.Fatalf("write config file: %v", err)
+	}
+
+	cfg := config.New()
+	src := &config.FileSource{Path: path}
+	if err := cfg.Load(src); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	changed := make(chan struct{}, 1)
+	cfg.OnChange(func(key string, oldVal, newVal any) {
+		if key == "tags" {
+			select {
+			case changed <- struct{}{}:
+			default:
+			}
+		}
+	})
+
+	if err := cfg.WatchSource(src); err != nil {
+		t.Fatalf("WatchSource failed: %v", err)
+	}
+	defer cfg.StopWatching()
+
+	if err := os.WriteFile(path, []byte("tags: [a, c]\n"), 0o644); err != nil {
+		t.Fatalf("rewrite config file: %v", err)
+	}
+
+	select {
+	case <-changed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for OnChange callback")
+	}
+
+	if val, ok := cfg.GetStringSlice("tags"); !ok || len(val) != 2 || val[1] != "c" {
+		t.Errorf("GetStringSlice after hot reload: got %v, %v", val, ok)
+	}
+}
+
+func TestConfigManager_WatchSource_RequiresWatchable(t *testing.T) {
+	cfg := config.New()
+	if err := cfg.WatchSource(&MockSource{values: map[string]any{}}); err == nil {
+		t.Error("expected an error watching a non-Watchable source")
+	}
+}